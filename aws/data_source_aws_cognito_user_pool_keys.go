@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type dataSourceAwsCognitoUserPoolKeysResult struct {
+	Keys []dataSourceAwsCognitoUserPoolKeysKey `json:"keys"`
+}
+
+type dataSourceAwsCognitoUserPoolKeysKey struct {
+	Alg string `json:"alg"`
+	E   string `json:"e"`
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	Use string `json:"use"`
+}
+
+func dataSourceAwsCognitoUserPoolKeys() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCognitoUserPoolKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"jwks_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alg": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"e": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kty": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"n": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCognitoUserPoolKeysRead(d *schema.ResourceData, meta interface{}) error {
+	userPoolId := d.Get("user_pool_id").(string)
+	issuer := cognitoUserPoolIssuer(meta.(*AWSClient), userPoolId)
+	jwksUri := fmt.Sprintf("%s/.well-known/jwks.json", issuer)
+
+	conn := cleanhttp.DefaultClient()
+	conn.Timeout = 30 * time.Second
+
+	log.Printf("[DEBUG] Reading Cognito User Pool keys: %s", jwksUri)
+
+	result, err := fetchCognitoUserPoolKeys(conn, jwksUri)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(userPoolId)
+	d.Set("issuer", issuer)
+	d.Set("jwks_uri", jwksUri)
+
+	keys := make([]map[string]interface{}, 0, len(result.Keys))
+	for _, k := range result.Keys {
+		keys = append(keys, map[string]interface{}{
+			"alg": k.Alg,
+			"e":   k.E,
+			"kid": k.Kid,
+			"kty": k.Kty,
+			"n":   k.N,
+			"use": k.Use,
+		})
+	}
+
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("Error setting keys: %s", err)
+	}
+
+	return nil
+}
+
+// User pool IDs are prefixed with the region of the pool, e.g. us-east-1_Zr231apJu.
+func cognitoUserPoolIssuer(client *AWSClient, userPoolId string) string {
+	region := strings.SplitN(userPoolId, "_", 2)[0]
+
+	dnsSuffix := "amazonaws.com"
+	if client.partition == "aws-cn" || client.IsChinaCloud() {
+		dnsSuffix = "amazonaws.com.cn"
+	}
+
+	return fmt.Sprintf("https://cognito-idp.%s.%s/%s", region, dnsSuffix, userPoolId)
+}
+
+func fetchCognitoUserPoolKeys(conn *http.Client, url string) (*dataSourceAwsCognitoUserPoolKeysResult, error) {
+	res, err := conn.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching Cognito User Pool keys: %s", err)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error fetching Cognito User Pool keys from %s: %s", url, res.Status)
+	}
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading response body: %s", err)
+	}
+
+	result := new(dataSourceAwsCognitoUserPoolKeysResult)
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("Error parsing Cognito User Pool keys: %s", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestCognitoUserPoolIssuer(t *testing.T) {
+	cases := []struct {
+		Client     *AWSClient
+		UserPoolId string
+		Expected   string
+	}{
+		{
+			Client:     &AWSClient{partition: "aws", region: "eu-west-1"},
+			UserPoolId: "eu-west-1_Zr231apJu",
+			Expected:   "https://cognito-idp.eu-west-1.amazonaws.com/eu-west-1_Zr231apJu",
+		},
+		{
+			Client:     &AWSClient{partition: "aws-cn", region: "cn-north-1"},
+			UserPoolId: "cn-north-1_Zr231apJu",
+			Expected:   "https://cognito-idp.cn-north-1.amazonaws.com.cn/cn-north-1_Zr231apJu",
+		},
+		{
+			Client:     &AWSClient{region: "cn-north-1"},
+			UserPoolId: "cn-north-1_Zr231apJu",
+			Expected:   "https://cognito-idp.cn-north-1.amazonaws.com.cn/cn-north-1_Zr231apJu",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := cognitoUserPoolIssuer(tc.Client, tc.UserPoolId); actual != tc.Expected {
+			t.Fatalf("Got %q, expected %q", actual, tc.Expected)
+		}
+	}
+}
+
+func TestFetchCognitoUserPoolKeys(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/jwks.json" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"keys":[{"alg":"RS256","e":"AQAB","kid":"abcdefghijklmnopqrsexample=","kty":"RSA","n":"lsjhglskjhgslkjgh43lj5h34lkjh34lkjht3example","use":"sig"}]}`)
+	}))
+	defer ts.Close()
+
+	result, err := fetchCognitoUserPoolKeys(cleanhttp.DefaultClient(), ts.URL+"/.well-known/jwks.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Keys) != 1 {
+		t.Fatalf("Expected 1 key, got %d", len(result.Keys))
+	}
+
+	expected := dataSourceAwsCognitoUserPoolKeysKey{
+		Alg: "RS256",
+		E:   "AQAB",
+		Kid: "abcdefghijklmnopqrsexample=",
+		Kty: "RSA",
+		N:   "lsjhglskjhgslkjgh43lj5h34lkjh34lkjht3example",
+		Use: "sig",
+	}
+	if result.Keys[0] != expected {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result.Keys[0], expected)
+	}
+
+	if _, err := fetchCognitoUserPoolKeys(cleanhttp.DefaultClient(), ts.URL+"/missing"); err == nil {
+		t.Fatal("Expected an error for a missing JWKS document")
+	}
+}
+
+// The provider has no user pool resource yet, so this test requires an
+// existing pool in AWS_COGNITO_USER_POOL_ID.
+func TestAccAWSCognitoUserPoolKeysDataSource_basic(t *testing.T) {
+	userPoolId := os.Getenv("AWS_COGNITO_USER_POOL_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if userPoolId == "" {
+				t.Fatal("AWS_COGNITO_USER_POOL_ID must be set")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolKeysDataSourceConfig(userPoolId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.aws_cognito_user_pool_keys.test", "issuer", regexp.MustCompile(`^https://cognito-idp\.[^/]+/`+userPoolId+`$`)),
+					resource.TestCheckResourceAttr("data.aws_cognito_user_pool_keys.test", "keys.0.kty", "RSA"),
+					resource.TestCheckResourceAttr("data.aws_cognito_user_pool_keys.test", "keys.0.alg", "RS256"),
+					resource.TestCheckResourceAttrSet("data.aws_cognito_user_pool_keys.test", "keys.0.kid"),
+					resource.TestCheckResourceAttrSet("data.aws_cognito_user_pool_keys.test", "keys.0.n"),
+					resource.TestCheckResourceAttrSet("data.aws_cognito_user_pool_keys.test", "keys.0.e"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCognitoUserPoolKeysDataSourceConfig(userPoolId string) string {
+	return fmt.Sprintf(`
+data "aws_cognito_user_pool_keys" "test" {
+  user_pool_id = "%s"
+}
+`, userPoolId)
+}
@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsCognitoUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCognitoUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+
+			"filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCognitoUserFilter,
+			},
+
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"sub": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCognitoUsersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.ListUsersInput{
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	if v, ok := d.GetOk("filter"); ok {
+		params.Filter = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Reading Cognito Users: %s", params)
+
+	var users []*cognitoidentityprovider.UserType
+	for {
+		resp, err := conn.ListUsers(params)
+		if err != nil {
+			return fmt.Errorf("Error listing Cognito Users: %s", err)
+		}
+
+		users = append(users, resp.Users...)
+
+		if resp.PaginationToken == nil || *resp.PaginationToken == "" {
+			break
+		}
+		params.PaginationToken = resp.PaginationToken
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("users", flattenCognitoUsers(users)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting users error: %#v", err)
+	}
+
+	return nil
+}
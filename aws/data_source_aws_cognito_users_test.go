@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The provider has no user pool resource yet, so this test requires an
+// existing pool in AWS_COGNITO_USER_POOL_ID to create its users in.
+func TestAccAWSCognitoUsersDataSource_filter(t *testing.T) {
+	userPoolId := os.Getenv("AWS_COGNITO_USER_POOL_ID")
+	prefix := fmt.Sprintf("tf-acc-%s", acctest.RandString(8))
+	usernames := []string{prefix + "-a", prefix + "-b", acctest.RandString(10)}
+
+	defer testAccDeleteCognitoUsers(userPoolId, usernames)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if userPoolId == "" {
+				t.Fatal("AWS_COGNITO_USER_POOL_ID must be set")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccCreateCognitoUsers(userPoolId, usernames); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSCognitoUsersDataSourceConfig_filter(userPoolId, prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_cognito_users.test", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_cognito_users.test", "users.0.status", "FORCE_CHANGE_PASSWORD"),
+					resource.TestCheckResourceAttr("data.aws_cognito_users.test", "users.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet("data.aws_cognito_users.test", "users.0.sub"),
+					resource.TestCheckResourceAttrSet("data.aws_cognito_users.test", "users.0.attributes.email"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCreateCognitoUsers(userPoolId string, usernames []string) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, u := range usernames {
+		_, err := conn.AdminCreateUser(&cognitoidentityprovider.AdminCreateUserInput{
+			UserPoolId:    aws.String(userPoolId),
+			Username:      aws.String(u),
+			MessageAction: aws.String(cognitoidentityprovider.MessageActionTypeSuppress),
+			UserAttributes: []*cognitoidentityprovider.AttributeType{
+				{
+					Name:  aws.String("email"),
+					Value: aws.String(fmt.Sprintf("%s@example.com", u)),
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating Cognito User %q: %s", u, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccDeleteCognitoUsers(userPoolId string, usernames []string) {
+	if userPoolId == "" || testAccProvider.Meta() == nil {
+		return
+	}
+
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, u := range usernames {
+		conn.AdminDeleteUser(&cognitoidentityprovider.AdminDeleteUserInput{
+			UserPoolId: aws.String(userPoolId),
+			Username:   aws.String(u),
+		})
+	}
+}
+
+func testAccAWSCognitoUsersDataSourceConfig_filter(userPoolId, prefix string) string {
+	return fmt.Sprintf(`
+data "aws_cognito_users" "test" {
+  user_pool_id = "%s"
+  filter       = "email ^= \"%s\""
+}
+`, userPoolId, prefix)
+}
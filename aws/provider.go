@@ -171,6 +171,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_canonical_user_id":          dataSourceAwsCanonicalUserId(),
 			"aws_cloudformation_stack":       dataSourceAwsCloudFormationStack(),
 			"aws_cloudtrail_service_account": dataSourceAwsCloudTrailServiceAccount(),
+			"aws_cognito_user_pool_keys":     dataSourceAwsCognitoUserPoolKeys(),
+			"aws_cognito_users":              dataSourceAwsCognitoUsers(),
 			"aws_db_instance":                dataSourceAwsDbInstance(),
 			"aws_db_snapshot":                dataSourceAwsDbSnapshot(),
 			"aws_dynamodb_table":             dataSourceAwsDynamoDbTable(),
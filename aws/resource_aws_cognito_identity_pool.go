@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -33,6 +35,7 @@ func resourceAwsCognitoIdentityPool() *schema.Resource {
 			"cognito_identity_providers": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Set:      cognitoIdentityProvidersHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"client_id": {
@@ -72,7 +75,7 @@ func resourceAwsCognitoIdentityPool() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validateArn,
+					ValidateFunc: validateIamOpenIdConnectProviderArn,
 				},
 			},
 
@@ -81,17 +84,15 @@ func resourceAwsCognitoIdentityPool() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validateArn,
+					ValidateFunc: validateIamSamlProviderArn,
 				},
 			},
 
 			"supported_login_providers": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validateCognitoSupportedLoginProviders,
-				},
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateCognitoSupportedLoginProvidersMap,
 			},
 		},
 	}
@@ -178,35 +179,25 @@ func resourceAwsCognitoIdentityPoolUpdate(d *schema.ResourceData, meta interface
 	conn := meta.(*AWSClient).cognitoconn
 	log.Print("[DEBUG] Updating Cognito Identity Pool")
 
+	// UpdateIdentityPool replaces the whole pool, so every attribute has
+	// to be sent, not only the ones that changed.
 	params := &cognitoidentity.IdentityPool{
 		IdentityPoolId:                 aws.String(d.Id()),
 		AllowUnauthenticatedIdentities: aws.Bool(d.Get("allow_unauthenticated_identities").(bool)),
 		IdentityPoolName:               aws.String(d.Get("identity_pool_name").(string)),
+		CognitoIdentityProviders:       expandCognitoIdentityProviders(d.Get("cognito_identity_providers").(*schema.Set)),
+		SupportedLoginProviders:        expandCognitoSupportedLoginProviders(d.Get("supported_login_providers").(map[string]interface{})),
+		OpenIdConnectProviderARNs:      expandStringList(d.Get("openid_connect_provider_arns").([]interface{})),
+		SamlProviderARNs:               expandStringList(d.Get("saml_provider_arns").([]interface{})),
 	}
 
-	if d.HasChange("developer_provider_name") {
-		params.DeveloperProviderName = aws.String(d.Get("developer_provider_name").(string))
-	}
-
-	if d.HasChange("cognito_identity_providers") {
-		params.CognitoIdentityProviders = expandCognitoIdentityProviders(d.Get("cognito_identity_providers").(*schema.Set))
-	}
-
-	if d.HasChange("supported_login_providers") {
-		params.SupportedLoginProviders = expandCognitoSupportedLoginProviders(d.Get("supported_login_providers").(map[string]interface{}))
-	}
-
-	if d.HasChange("openid_connect_provider_arns") {
-		params.OpenIdConnectProviderARNs = expandStringList(d.Get("openid_connect_provider_arns").([]interface{}))
-	}
-
-	if d.HasChange("saml_provider_arns") {
-		params.SamlProviderARNs = expandStringList(d.Get("saml_provider_arns").([]interface{}))
+	if v, ok := d.GetOk("developer_provider_name"); ok {
+		params.DeveloperProviderName = aws.String(v.(string))
 	}
 
 	_, err := conn.UpdateIdentityPool(params)
 	if err != nil {
-		return fmt.Errorf("Error creating Cognito Identity Pool: %s", err)
+		return fmt.Errorf("Error updating Cognito Identity Pool: %s", err)
 	}
 
 	return resourceAwsCognitoIdentityPoolRead(d, meta)
@@ -228,3 +219,19 @@ func resourceAwsCognitoIdentityPoolDelete(d *schema.ResourceData, meta interface
 		return resource.NonRetryableError(err)
 	})
 }
+
+// Providers are identified by the user pool and app client they point to,
+// so the hash ignores server_side_token_check. This keeps the set stable
+// when the API returns providers in a different order.
+func cognitoIdentityProvidersHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	if v, ok := m["provider_name"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["client_id"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+
+	return hashcode.String(buf.String())
+}
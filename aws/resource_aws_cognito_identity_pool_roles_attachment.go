@@ -22,6 +22,8 @@ func resourceAwsCognitoIdentityPoolRolesAttachment() *schema.Resource {
 		Update: resourceAwsCognitoIdentityPoolRolesAttachmentUpdate,
 		Delete: resourceAwsCognitoIdentityPoolRolesAttachmentDelete,
 
+		CustomizeDiff: resourceAwsCognitoIdentityPoolRolesAttachmentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"identity_pool_id": {
 				Type:     schema.TypeString,
@@ -119,12 +121,6 @@ func resourceAwsCognitoIdentityPoolRolesAttachmentCreate(d *schema.ResourceData,
 	}
 
 	if v, ok := d.GetOk("role_mapping"); ok {
-		errors := validateRoleMappings(v.(*schema.Set).List())
-
-		if len(errors) > 0 {
-			return fmt.Errorf("Error validating ambiguous role resolution: %v", errors)
-		}
-
 		params.RoleMappings = expandCognitoIdentityPoolRoleMappingsAttachment(v.(*schema.Set).List())
 	}
 
@@ -180,23 +176,9 @@ func resourceAwsCognitoIdentityPoolRolesAttachmentUpdate(d *schema.ResourceData,
 		Roles:          expandCognitoIdentityPoolRoles(d.Get("roles").(map[string]interface{})),
 	}
 
-	if d.HasChange("role_mapping") {
-		v, ok := d.GetOk("role_mapping")
-		var mappings []interface{}
-
-		if ok {
-			errors := validateRoleMappings(v.(*schema.Set).List())
-
-			if len(errors) > 0 {
-				return fmt.Errorf("Error validating ambiguous role resolution: %v", errors)
-			}
-			mappings = v.(*schema.Set).List()
-		} else {
-			mappings = []interface{}{}
-		}
-
-		params.RoleMappings = expandCognitoIdentityPoolRoleMappingsAttachment(mappings)
-	}
+	// SetIdentityPoolRoles replaces all the role mappings of the pool, so the
+	// complete list is always sent. An empty list clears removed mappings.
+	params.RoleMappings = expandCognitoIdentityPoolRoleMappingsAttachment(d.Get("role_mapping").(*schema.Set).List())
 
 	log.Printf("[DEBUG] Updating Cognito Identity Pool Roles Association: %#v", params)
 	_, err := conn.SetIdentityPoolRoles(params)
@@ -228,6 +210,18 @@ func resourceAwsCognitoIdentityPoolRolesAttachmentDelete(d *schema.ResourceData,
 	})
 }
 
+// Role mappings are validated against their type at plan time, instead of
+// failing on the SetIdentityPoolRoles call during apply.
+func resourceAwsCognitoIdentityPoolRolesAttachmentCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("role_mapping"); ok {
+		if errors := validateRoleMappings(v.(*schema.Set).List()); len(errors) > 0 {
+			return fmt.Errorf("Error validating ambiguous role resolution: %v", errors)
+		}
+	}
+
+	return nil
+}
+
 // Validating that each role_mapping ambiguous_role_resolution
 // is defined when "type" equals Token or Rules.
 func validateRoleMappings(roleMappings []interface{}) []error {
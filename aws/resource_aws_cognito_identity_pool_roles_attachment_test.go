@@ -57,6 +57,7 @@ func TestAccAWSCognitoIdentityPoolRolesAttachment_roleMappings(t *testing.T) {
 					testAccCheckAWSCognitoIdentityPoolRolesAttachmentExists("aws_cognito_identity_pool_roles_attachment.main"),
 					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "identity_pool_id"),
 					resource.TestCheckResourceAttr("aws_cognito_identity_pool_roles_attachment.main", "role_mapping.#", "0"),
+					testAccCheckAWSCognitoIdentityPoolRolesAttachmentRoleMappingCount("aws_cognito_identity_pool_roles_attachment.main", 0),
 					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "roles.authenticated"),
 				),
 			},
@@ -66,15 +67,27 @@ func TestAccAWSCognitoIdentityPoolRolesAttachment_roleMappings(t *testing.T) {
 					testAccCheckAWSCognitoIdentityPoolRolesAttachmentExists("aws_cognito_identity_pool_roles_attachment.main"),
 					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "identity_pool_id"),
 					resource.TestCheckResourceAttr("aws_cognito_identity_pool_roles_attachment.main", "role_mapping.#", "1"),
+					testAccCheckAWSCognitoIdentityPoolRolesAttachmentRoleMappingCount("aws_cognito_identity_pool_roles_attachment.main", 1),
 					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "roles.authenticated"),
 				),
 			},
+			{
+				Config: testAccAWSCognitoIdentityPoolRolesAttachmentConfig_roleMappingsWithUnauthenticatedRole(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityPoolRolesAttachmentExists("aws_cognito_identity_pool_roles_attachment.main"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_pool_roles_attachment.main", "role_mapping.#", "1"),
+					testAccCheckAWSCognitoIdentityPoolRolesAttachmentRoleMappingCount("aws_cognito_identity_pool_roles_attachment.main", 1),
+					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "roles.authenticated"),
+					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "roles.unauthenticated"),
+				),
+			},
 			{
 				Config: testAccAWSCognitoIdentityPoolRolesAttachmentConfig_roleMappingsUpdated(name),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckAWSCognitoIdentityPoolRolesAttachmentExists("aws_cognito_identity_pool_roles_attachment.main"),
 					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "identity_pool_id"),
 					resource.TestCheckResourceAttr("aws_cognito_identity_pool_roles_attachment.main", "role_mapping.#", "1"),
+					testAccCheckAWSCognitoIdentityPoolRolesAttachmentRoleMappingCount("aws_cognito_identity_pool_roles_attachment.main", 1),
 					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "roles.authenticated"),
 				),
 			},
@@ -84,6 +97,7 @@ func TestAccAWSCognitoIdentityPoolRolesAttachment_roleMappings(t *testing.T) {
 					testAccCheckAWSCognitoIdentityPoolRolesAttachmentExists("aws_cognito_identity_pool_roles_attachment.main"),
 					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "identity_pool_id"),
 					resource.TestCheckResourceAttr("aws_cognito_identity_pool_roles_attachment.main", "role_mapping.#", "0"),
+					testAccCheckAWSCognitoIdentityPoolRolesAttachmentRoleMappingCount("aws_cognito_identity_pool_roles_attachment.main", 0),
 					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "roles.authenticated"),
 				),
 			},
@@ -91,6 +105,60 @@ func TestAccAWSCognitoIdentityPoolRolesAttachment_roleMappings(t *testing.T) {
 	})
 }
 
+func TestAccAWSCognitoIdentityPoolRolesAttachment_roleMappingsTokenType(t *testing.T) {
+	name := fmt.Sprintf("%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoIdentityPoolRolesAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoIdentityPoolRolesAttachmentConfig_roleMappingsTokenType(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityPoolRolesAttachmentExists("aws_cognito_identity_pool_roles_attachment.main"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_pool_roles_attachment.main", "role_mapping.#", "1"),
+					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "roles.authenticated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoIdentityPoolRolesAttachment_identityPoolUpdate(t *testing.T) {
+	name := fmt.Sprintf("%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoIdentityPoolRolesAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoIdentityPoolRolesAttachmentConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityPoolRolesAttachmentExists("aws_cognito_identity_pool_roles_attachment.main"),
+					testAccCheckAWSCognitoIdentityPoolRolesAttachmentHasRole("aws_cognito_identity_pool_roles_attachment.main", "authenticated"),
+				),
+			},
+			{
+				Config:   testAccAWSCognitoIdentityPoolRolesAttachmentConfig_basic(name),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSCognitoIdentityPoolRolesAttachmentConfig_unauthenticatedIdentities(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_identity_pool.main", "allow_unauthenticated_identities", "true"),
+					testAccCheckAWSCognitoIdentityPoolRolesAttachmentHasRole("aws_cognito_identity_pool_roles_attachment.main", "authenticated"),
+				),
+			},
+			{
+				Config:   testAccAWSCognitoIdentityPoolRolesAttachmentConfig_unauthenticatedIdentities(name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSCognitoIdentityPoolRolesAttachment_roleMappingsWithAmbiguousRoleResolutionError(t *testing.T) {
 	name := fmt.Sprintf("%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
@@ -101,6 +169,7 @@ func TestAccAWSCognitoIdentityPoolRolesAttachment_roleMappingsWithAmbiguousRoleR
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccAWSCognitoIdentityPoolRolesAttachmentConfig_roleMappingsWithAmbiguousRoleResolutionError(name),
+				PlanOnly:    true,
 				ExpectError: regexp.MustCompile(`Error validating ambiguous role resolution`),
 			},
 		},
@@ -164,6 +233,54 @@ func testAccCheckAWSCognitoIdentityPoolRolesAttachmentExists(n string) resource.
 	}
 }
 
+func testAccCheckAWSCognitoIdentityPoolRolesAttachmentHasRole(n, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoconn
+
+		resp, err := conn.GetIdentityPoolRoles(&cognitoidentity.GetIdentityPoolRolesInput{
+			IdentityPoolId: aws.String(rs.Primary.Attributes["identity_pool_id"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if v, ok := resp.Roles[role]; !ok || aws.StringValue(v) != rs.Primary.Attributes["roles."+role] {
+			return fmt.Errorf("Expected %q role %q, got: %#v", role, rs.Primary.Attributes["roles."+role], resp.Roles)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCognitoIdentityPoolRolesAttachmentRoleMappingCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoconn
+
+		resp, err := conn.GetIdentityPoolRoles(&cognitoidentity.GetIdentityPoolRolesInput{
+			IdentityPoolId: aws.String(rs.Primary.Attributes["identity_pool_id"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.RoleMappings) != count {
+			return fmt.Errorf("Expected %d role mappings, got: %#v", count, resp.RoleMappings)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSCognitoIdentityPoolRolesAttachmentDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).cognitoconn
 
@@ -188,10 +305,14 @@ func testAccCheckAWSCognitoIdentityPoolRolesAttachmentDestroy(s *terraform.State
 }
 
 func baseAWSCognitoIdentityPoolRolesAttachmentConfig(name string) string {
+	return baseAWSCognitoIdentityPoolRolesAttachmentConfigWithUnauthenticated(name, false)
+}
+
+func baseAWSCognitoIdentityPoolRolesAttachmentConfigWithUnauthenticated(name string, allow bool) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_identity_pool" "main" {
   identity_pool_name               = "identity pool %[1]s"
-  allow_unauthenticated_identities = false
+  allow_unauthenticated_identities = %[2]t
 
   supported_login_providers {
     "graph.facebook.com" = "7346241598935555"
@@ -300,7 +421,7 @@ resource "aws_iam_role_policy" "authenticated" {
 }
 EOF
 }
-`, name)
+`, name, allow)
 }
 
 func testAccAWSCognitoIdentityPoolRolesAttachmentConfig_basic(name string) string {
@@ -315,6 +436,18 @@ resource "aws_cognito_identity_pool_roles_attachment" "main" {
 `)
 }
 
+func testAccAWSCognitoIdentityPoolRolesAttachmentConfig_unauthenticatedIdentities(name string) string {
+	return baseAWSCognitoIdentityPoolRolesAttachmentConfigWithUnauthenticated(name, true) + `
+resource "aws_cognito_identity_pool_roles_attachment" "main" {
+  identity_pool_id = "${aws_cognito_identity_pool.main.id}"
+
+  roles {
+    "authenticated" = "${aws_iam_role.authenticated.arn}"
+  }
+}
+`
+}
+
 func testAccAWSCognitoIdentityPoolRolesAttachmentConfig_roleMappings(name string) string {
 	return fmt.Sprintf(baseAWSCognitoIdentityPoolRolesAttachmentConfig(name) + `
 resource "aws_cognito_identity_pool_roles_attachment" "main" {
@@ -340,6 +473,32 @@ resource "aws_cognito_identity_pool_roles_attachment" "main" {
 `)
 }
 
+func testAccAWSCognitoIdentityPoolRolesAttachmentConfig_roleMappingsWithUnauthenticatedRole(name string) string {
+	return baseAWSCognitoIdentityPoolRolesAttachmentConfig(name) + `
+resource "aws_cognito_identity_pool_roles_attachment" "main" {
+  identity_pool_id = "${aws_cognito_identity_pool.main.id}"
+
+  role_mapping {
+    identity_provider         = "graph.facebook.com"
+    ambiguous_role_resolution = "AuthenticatedRole"
+    type                      = "Rules"
+
+    mapping_rule {
+      claim      = "isAdmin"
+      match_type = "Equals"
+      role_arn   = "${aws_iam_role.authenticated.arn}"
+      value      = "paid"
+    }
+  }
+
+  roles {
+    "authenticated"   = "${aws_iam_role.authenticated.arn}"
+    "unauthenticated" = "${aws_iam_role.unauthenticated.arn}"
+  }
+}
+`
+}
+
 func testAccAWSCognitoIdentityPoolRolesAttachmentConfig_roleMappingsUpdated(name string) string {
 	return fmt.Sprintf(baseAWSCognitoIdentityPoolRolesAttachmentConfig(name) + `
 resource "aws_cognito_identity_pool_roles_attachment" "main" {
@@ -372,6 +531,24 @@ resource "aws_cognito_identity_pool_roles_attachment" "main" {
 `)
 }
 
+func testAccAWSCognitoIdentityPoolRolesAttachmentConfig_roleMappingsTokenType(name string) string {
+	return baseAWSCognitoIdentityPoolRolesAttachmentConfig(name) + `
+resource "aws_cognito_identity_pool_roles_attachment" "main" {
+  identity_pool_id = "${aws_cognito_identity_pool.main.id}"
+
+  role_mapping {
+    identity_provider         = "graph.facebook.com"
+    ambiguous_role_resolution = "AuthenticatedRole"
+    type                      = "Token"
+  }
+
+  roles {
+    "authenticated" = "${aws_iam_role.authenticated.arn}"
+  }
+}
+`
+}
+
 func testAccAWSCognitoIdentityPoolRolesAttachmentConfig_roleMappingsWithAmbiguousRoleResolutionError(name string) string {
 	return fmt.Sprintf(baseAWSCognitoIdentityPoolRolesAttachmentConfig(name) + `
 resource "aws_cognito_identity_pool_roles_attachment" "main" {
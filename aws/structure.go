@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -2113,12 +2114,12 @@ func flattenCognitoIdentityProviders(ips []*cognitoidentity.Provider) []map[stri
 	values := make([]map[string]interface{}, 0)
 
 	for _, v := range ips {
-		ip := make(map[string]interface{})
-
 		if v == nil {
-			return nil
+			continue
 		}
 
+		ip := make(map[string]interface{})
+
 		if v.ClientId != nil {
 			ip["client_id"] = *v.ClientId
 		}
@@ -2340,6 +2341,46 @@ func flattenCognitoIdentityPoolRolesAttachmentMappingRules(d []*cognitoidentity.
 	return rules
 }
 
+func flattenCognitoUsers(users []*cognitoidentityprovider.UserType) []map[string]interface{} {
+	values := make([]map[string]interface{}, 0, len(users))
+
+	for _, v := range users {
+		if v == nil {
+			continue
+		}
+
+		u := make(map[string]interface{})
+		attributes := make(map[string]interface{})
+
+		for _, a := range v.Attributes {
+			if a.Name == nil || a.Value == nil {
+				continue
+			}
+			if *a.Name == "sub" {
+				u["sub"] = *a.Value
+			}
+			attributes[*a.Name] = *a.Value
+		}
+		u["attributes"] = attributes
+
+		if v.Username != nil {
+			u["username"] = *v.Username
+		}
+
+		if v.UserStatus != nil {
+			u["status"] = *v.UserStatus
+		}
+
+		if v.Enabled != nil {
+			u["enabled"] = *v.Enabled
+		}
+
+		values = append(values, u)
+	}
+
+	return values
+}
+
 func flattenRedshiftLogging(ls *redshift.LoggingStatus) []interface{} {
 	if ls == nil {
 		return []interface{}{}
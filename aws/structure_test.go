@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elb"
@@ -1322,3 +1323,65 @@ func TestNormalizeCloudFormationTemplate(t *testing.T) {
 		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", actual, validNormalizedYaml)
 	}
 }
+
+func TestCognitoIdentityProvidersHash(t *testing.T) {
+	provider := map[string]interface{}{
+		"client_id":               "7lhlkkfbfb4q5kpp90urffao",
+		"provider_name":           "cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu",
+		"server_side_token_check": false,
+	}
+	tokenCheck := map[string]interface{}{
+		"client_id":               "7lhlkkfbfb4q5kpp90urffao",
+		"provider_name":           "cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu",
+		"server_side_token_check": true,
+	}
+	otherClient := map[string]interface{}{
+		"client_id":               "6lhlkkfbfb4q5kpp90urffae",
+		"provider_name":           "cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu",
+		"server_side_token_check": false,
+	}
+	otherPool := map[string]interface{}{
+		"client_id":               "7lhlkkfbfb4q5kpp90urffao",
+		"provider_name":           "cognito-idp.us-east-1.amazonaws.com/us-east-1_Ab129faBb",
+		"server_side_token_check": false,
+	}
+
+	expected := cognitoIdentityProvidersHash(provider)
+
+	if actual := cognitoIdentityProvidersHash(tokenCheck); actual != expected {
+		t.Fatalf("Expected server_side_token_check not to change the hash, got %d and %d", actual, expected)
+	}
+
+	if actual := cognitoIdentityProvidersHash(otherClient); actual == expected {
+		t.Fatalf("Expected a different client_id to change the hash, got %d for both", actual)
+	}
+
+	if actual := cognitoIdentityProvidersHash(otherPool); actual == expected {
+		t.Fatalf("Expected a different provider_name to change the hash, got %d for both", actual)
+	}
+}
+
+func TestFlattenCognitoIdentityProviders_nil(t *testing.T) {
+	ips := []*cognitoidentity.Provider{
+		{
+			ClientId:             aws.String("7lhlkkfbfb4q5kpp90urffao"),
+			ProviderName:         aws.String("cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu"),
+			ServerSideTokenCheck: aws.Bool(false),
+		},
+		nil,
+		{
+			ClientId:             aws.String("6lhlkkfbfb4q5kpp90urffae"),
+			ProviderName:         aws.String("cognito-idp.us-east-1.amazonaws.com/us-east-1_Ab129faBb"),
+			ServerSideTokenCheck: aws.Bool(true),
+		},
+	}
+
+	result := flattenCognitoIdentityProviders(ips)
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 providers, got %d: %#v", len(result), result)
+	}
+
+	if result[1]["client_id"] != "6lhlkkfbfb4q5kpp90urffae" {
+		t.Fatalf("Expected the provider after nil to be kept, got: %#v", result[1])
+	}
+}
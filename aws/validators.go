@@ -1402,6 +1402,41 @@ func validateCognitoSupportedLoginProviders(v interface{}, k string) (ws []strin
 	return
 }
 
+// Validates the supported_login_providers map as a whole, since the map
+// keys are the provider domains and ValidateFunc is not called per element.
+func validateCognitoSupportedLoginProvidersMap(v interface{}, k string) (ws []string, errors []error) {
+	validProviders := []string{
+		"accounts.google.com",
+		"api.twitter.com",
+		"appleid.apple.com",
+		"graph.facebook.com",
+		"www.amazon.com",
+		"www.digits.com",
+	}
+
+	for provider, appId := range v.(map[string]interface{}) {
+		valid := false
+		for _, p := range validProviders {
+			if provider == p {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			errors = append(errors, fmt.Errorf(
+				"%q contains an invalid provider %q. Valid providers are %q.",
+				k, provider, validProviders))
+		}
+
+		if s, ok := appId.(string); ok {
+			_, es := validateCognitoSupportedLoginProviders(s, fmt.Sprintf("%s.%s", k, provider))
+			errors = append(errors, es...)
+		}
+	}
+
+	return
+}
+
 func validateCognitoIdentityProvidersClientId(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) < 1 {
@@ -1412,8 +1447,8 @@ func validateCognitoIdentityProvidersClientId(v interface{}, k string) (ws []str
 		errors = append(errors, fmt.Errorf("%q cannot be longer than 128 caracters", k))
 	}
 
-	if !regexp.MustCompile("^[\\w_]+$").MatchString(value) {
-		errors = append(errors, fmt.Errorf("%q must contain only alphanumeric caracters and underscores", k))
+	if !regexp.MustCompile("^[\\w+]+$").MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only alphanumeric caracters, underscores and plus signs", k))
 	}
 
 	return
@@ -1436,6 +1471,62 @@ func validateCognitoIdentityProvidersProviderName(v interface{}, k string) (ws [
 	return
 }
 
+func validateIamSamlProviderArn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// arn:aws:iam::123456789012:saml-provider/tf-salesforce-test
+	pattern := `^arn:[\w-]+:iam::\d{12}:saml-provider/[\w.-]{1,128}$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't look like a valid IAM SAML provider ARN (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
+
+func validateIamOpenIdConnectProviderArn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// arn:aws:iam::123456789012:oidc-provider/server.example.com
+	pattern := `^arn:[\w-]+:iam::\d{12}:oidc-provider/\S+$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't look like a valid IAM OpenID Connect provider ARN (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
+
+// Validates the general shape of a ListUsers filter, e.g. email ^= "bob".
+// The attributes that can be filtered on are left for the API to check.
+func validateCognitoUserFilter(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 256 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 256 caracters", k))
+	}
+
+	if !regexp.MustCompile(`^[\w:]+ *\^?= *"[^"]*"$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be of the form: attribute_name = \"value\" or attribute_name ^= \"value\"", k))
+	}
+
+	return
+}
+
+func validateCognitoUserPoolId(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 55 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 55 caracters", k))
+	}
+
+	if !regexp.MustCompile(`^[\w-]+_[0-9a-zA-Z]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be of the form <region>_<id>, e.g. us-east-1_Zr231apJu", k))
+	}
+
+	return
+}
+
 func validateWafMetricName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[0-9A-Za-z]+$`).MatchString(value) {
@@ -1575,14 +1666,18 @@ func validateCognitoRoleMappingsAmbiguousRoleResolutionAgainstType(v map[string]
 
 func validateCognitoRoleMappingsRulesConfiguration(v map[string]interface{}) (errors []error) {
 	t := v["type"].(string)
-	value, ok := v["mapping_rule"]
-	valLength := len(value.([]interface{}))
+	// mapping_rule is always present in a role_mapping, as an empty list when
+	// not configured, so only its length tells whether rules were given.
+	valLength := 0
+	if value, ok := v["mapping_rule"].([]interface{}); ok {
+		valLength = len(value)
+	}
 
-	if (!ok || valLength == 0) && t == cognitoidentity.RoleMappingTypeRules {
+	if valLength == 0 && t == cognitoidentity.RoleMappingTypeRules {
 		errors = append(errors, fmt.Errorf("mapping_rule is required for Rules"))
 	}
 
-	if (ok || valLength > 0) && t == cognitoidentity.RoleMappingTypeToken {
+	if valLength > 0 && t == cognitoidentity.RoleMappingTypeToken {
 		errors = append(errors, fmt.Errorf("mapping_rule must not be set for Token based role mapping"))
 	}
 
@@ -2255,11 +2255,49 @@ func TestValidateCognitoSupportedLoginProviders(t *testing.T) {
 	}
 }
 
+func TestValidateCognitoSupportedLoginProvidersMap(t *testing.T) {
+	validValues := []map[string]interface{}{
+		{},
+		{
+			"graph.facebook.com":  "7346241598935552",
+			"accounts.google.com": "123456789012.apps.googleusercontent.com",
+		},
+		{
+			"www.amazon.com":    "amzn1.application.1234567890",
+			"api.twitter.com":   "xvz1evFS4wEEPTGEFPHBog;kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw",
+			"www.digits.com":    "xvz1evFS4wEEPTGEFPHBog;kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw",
+			"appleid.apple.com": "com.example.app",
+		},
+	}
+
+	for _, m := range validValues {
+		_, errors := validateCognitoSupportedLoginProvidersMap(m, "supported_login_providers")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid Cognito Supported Login Providers map: %v", m, errors)
+		}
+	}
+
+	invalidValues := []map[string]interface{}{
+		{"facebook.com": "7346241598935552"},
+		{"my.developer": "7346241598935552"},
+		{"graph.facebook.com": ""},
+		{"accounts.google.com": "foo:bar_baz"},
+	}
+
+	for _, m := range invalidValues {
+		_, errors := validateCognitoSupportedLoginProvidersMap(m, "supported_login_providers")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Cognito Supported Login Providers map: %v", m, errors)
+		}
+	}
+}
+
 func TestValidateCognitoIdentityProvidersClientId(t *testing.T) {
 	validValues := []string{
 		"7lhlkkfbfb4q5kpp90urffao",
 		"12345678",
 		"foo_123",
+		"foo+123",
 		strings.Repeat("W", 128),
 	}
 
@@ -2276,6 +2314,7 @@ func TestValidateCognitoIdentityProvidersClientId(t *testing.T) {
 		"foo-bar",
 		"foo:bar",
 		"foo;bar",
+		"foo bar",
 	}
 
 	for _, s := range invalidValues {
@@ -2321,6 +2360,132 @@ func TestValidateCognitoIdentityProvidersProviderName(t *testing.T) {
 	}
 }
 
+func TestValidateIamSamlProviderArn(t *testing.T) {
+	validValues := []string{
+		"arn:aws:iam::123456789012:saml-provider/tf-salesforce-test",
+		"arn:aws-us-gov:iam::123456789012:saml-provider/ADFS",
+		"arn:aws:iam::123456789012:saml-provider/my.provider_1",
+	}
+
+	for _, s := range validValues {
+		_, errors := validateIamSamlProviderArn(s, "saml_provider_arns")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid IAM SAML provider ARN: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"arn:aws:iam::123456789012:oidc-provider/server.example.com",
+		"arn:aws:iam::123456789012:role/saml-provider",
+		"arn:aws:iam::12345:saml-provider/foo",
+		"arn:aws:iam::123456789012:saml-provider/",
+		"saml-provider/foo",
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateIamSamlProviderArn(s, "saml_provider_arns")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid IAM SAML provider ARN: %v", s, errors)
+		}
+	}
+}
+
+func TestValidateIamOpenIdConnectProviderArn(t *testing.T) {
+	validValues := []string{
+		"arn:aws:iam::123456789012:oidc-provider/server.example.com",
+		"arn:aws:iam::123456789012:oidc-provider/accounts.google.com",
+		"arn:aws-cn:iam::123456789012:oidc-provider/example.com/path",
+	}
+
+	for _, s := range validValues {
+		_, errors := validateIamOpenIdConnectProviderArn(s, "openid_connect_provider_arns")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid IAM OpenID Connect provider ARN: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"arn:aws:iam::123456789012:saml-provider/ADFS",
+		"arn:aws:iam::123456789012:oidc-provider/",
+		"arn:aws:iam::12345:oidc-provider/server.example.com",
+		"oidc-provider/server.example.com",
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateIamOpenIdConnectProviderArn(s, "openid_connect_provider_arns")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid IAM OpenID Connect provider ARN: %v", s, errors)
+		}
+	}
+}
+
+func TestValidateCognitoUserFilter(t *testing.T) {
+	validValues := []string{
+		`email ^= "bob"`,
+		`username = "johndoe"`,
+		`cognito:user_status = "UNCONFIRMED"`,
+		`phone_number^="+1312"`,
+		`name = ""`,
+	}
+
+	for _, s := range validValues {
+		_, errors := validateCognitoUserFilter(s, "filter")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid Cognito User Filter: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		`email`,
+		`email ^= bob`,
+		`email != "bob"`,
+		`"email" = "bob"`,
+		`email = "bob" and name = "john"`,
+		`email = "` + strings.Repeat("W", 256) + `"`,
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateCognitoUserFilter(s, "filter")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Cognito User Filter: %v", s, errors)
+		}
+	}
+}
+
+func TestValidateCognitoUserPoolId(t *testing.T) {
+	validValues := []string{
+		"us-east-1_Zr231apJu",
+		"eu-west-1_123456789",
+		"us-gov-west-1_abcDEF",
+	}
+
+	for _, s := range validValues {
+		_, errors := validateCognitoUserPoolId(s, "user_pool_id")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid Cognito User Pool ID: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"Zr231apJu",
+		"us-east-1_",
+		"us-east-1_Zr231-apJu",
+		"us-east-1:Zr231apJu",
+		"us-east-1_" + strings.Repeat("W", 46), // > 55
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateCognitoUserPoolId(s, "user_pool_id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Cognito User Pool ID: %v", s, errors)
+		}
+	}
+}
+
 func TestValidateWafMetricName(t *testing.T) {
 	validNames := []string{
 		"testrule",
@@ -2492,6 +2657,66 @@ func TestValidateCognitoRoleMappingsAmbiguousRoleResolutionAgainstType(t *testin
 	}
 }
 
+func TestValidateCognitoRoleMappingsRulesConfiguration(t *testing.T) {
+	rule := map[string]interface{}{
+		"claim":      "isAdmin",
+		"match_type": cognitoidentity.MappingRuleMatchTypeEquals,
+		"role_arn":   "arn:aws:iam::123456789012:role/admin",
+		"value":      "paid",
+	}
+
+	cases := []struct {
+		MappingRule interface{}
+		Type        string
+		ErrCount    int
+	}{
+		{
+			MappingRule: nil,
+			Type:        cognitoidentity.RoleMappingTypeToken,
+			ErrCount:    0,
+		},
+		{
+			MappingRule: []interface{}{},
+			Type:        cognitoidentity.RoleMappingTypeToken,
+			ErrCount:    0,
+		},
+		{
+			MappingRule: []interface{}{rule},
+			Type:        cognitoidentity.RoleMappingTypeToken,
+			ErrCount:    1,
+		},
+		{
+			MappingRule: nil,
+			Type:        cognitoidentity.RoleMappingTypeRules,
+			ErrCount:    1,
+		},
+		{
+			MappingRule: []interface{}{},
+			Type:        cognitoidentity.RoleMappingTypeRules,
+			ErrCount:    1,
+		},
+		{
+			MappingRule: []interface{}{rule},
+			Type:        cognitoidentity.RoleMappingTypeRules,
+			ErrCount:    0,
+		},
+	}
+
+	for _, tc := range cases {
+		m := make(map[string]interface{})
+		// Reproducing the undefined mapping_rule
+		if tc.MappingRule != nil {
+			m["mapping_rule"] = tc.MappingRule
+		}
+		m["type"] = tc.Type
+
+		errors := validateCognitoRoleMappingsRulesConfiguration(m)
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Cognito Role Mappings validation failed: %v, expected err count %d, got %d, for config %#v", errors, tc.ErrCount, len(errors), m)
+		}
+	}
+}
+
 func TestValidateCognitoRoleMappingsAmbiguousRoleResolution(t *testing.T) {
 	validValues := []string{
 		cognitoidentity.AmbiguousRoleResolutionTypeAuthenticatedRole,